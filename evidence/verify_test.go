@@ -449,6 +449,24 @@ func TestVerifyDuplicateVoteEvidence(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestVerifyDuplicateVoteEvidenceUnknownValidator(t *testing.T) {
+	val := types.NewMockPV()
+	outsider := types.NewMockPV()
+	valSet := types.NewValidatorSet([]*types.Validator{val.ExtractIntoValidator(1)})
+
+	const chainID = "mychain"
+
+	// both votes are validly signed by a key that is not in the validator set
+	ev := types.NewMockDuplicateVoteEvidenceWithValidator(10, defaultEvidenceTime, outsider, chainID)
+
+	var err error
+	require.NotPanics(t, func() {
+		err = evidence.VerifyDuplicateVote(ev, chainID, valSet)
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "was not a validator")
+}
+
 func makeLunaticEvidence(
 	t *testing.T,
 	height, commonHeight int64,
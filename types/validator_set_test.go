@@ -362,6 +362,101 @@ func TestProposerSelection3(t *testing.T) {
 	}
 }
 
+func TestProposerSelectionEqualPowerLongRun(t *testing.T) {
+	const (
+		numVals    = 7
+		numHeights = 10000
+	)
+
+	// insert the validators in reverse address order so that the result does not
+	// depend on the order in which they were passed to NewValidatorSet
+	valList := make([]*Validator, numVals)
+	for i := 0; i < numVals; i++ {
+		addr := make([]byte, 20)
+		addr[19] = byte(numVals - 1 - i)
+		valList[i] = newValidator(addr, 10)
+	}
+	vset := NewValidatorSet(valList)
+
+	propCount := make([]int, numVals)
+	for i := 0; i < numHeights; i++ {
+		propCount[vset.GetProposer().Address[19]]++
+		vset.IncrementProposerPriority(1)
+	}
+
+	expected := numHeights / numVals
+	for i, count := range propCount {
+		assert.InDelta(t, expected, count, 1,
+			"validator %d proposed %d times, expected %d +/- 1", i, count, expected)
+	}
+}
+
+func TestProposerSelectionBoundedConsecutiveProposals(t *testing.T) {
+	const numHeights = 1000
+
+	addr := func(i byte) []byte {
+		a := make([]byte, 20)
+		a[19] = i
+		return a
+	}
+	// maxConsecutive returns the longest run of consecutive proposals by addr
+	// over the next n heights.
+	maxConsecutive := func(vset *ValidatorSet, addr []byte, n int) (longest int) {
+		run := 0
+		for i := 0; i < n; i++ {
+			if bytes.Equal(vset.GetProposer().Address, addr) {
+				run++
+				if run > longest {
+					longest = run
+				}
+			} else {
+				run = 0
+			}
+			vset.IncrementProposerPriority(1)
+		}
+		return longest
+	}
+
+	t.Run("inflated priority", func(t *testing.T) {
+		vset := NewValidatorSet([]*Validator{
+			newValidator(addr(0), 10),
+			newValidator(addr(1), 10),
+			newValidator(addr(2), 10),
+			newValidator(addr(3), 10),
+		})
+		// the priority is rescaled to within PriorityWindowSizeFactor*TotalVotingPower
+		// of the others, so the validator can't hog the proposer slot
+		idx, _ := vset.GetByAddress(addr(3))
+		vset.Validators[idx].ProposerPriority = MaxTotalVotingPower
+
+		assert.LessOrEqual(t, maxConsecutive(vset, addr(3), numHeights), PriorityWindowSizeFactor+1)
+	})
+
+	t.Run("removed then re-added", func(t *testing.T) {
+		vset := NewValidatorSet([]*Validator{
+			newValidator(addr(0), 10),
+			newValidator(addr(1), 10),
+			newValidator(addr(2), 10),
+			newValidator(addr(3), 10),
+		})
+		vset.IncrementProposerPriority(numHeights)
+
+		require.NoError(t, vset.UpdateWithChangeSet([]*Validator{newValidator(addr(3), 0)}))
+		vset.IncrementProposerPriority(numHeights)
+		require.NoError(t, vset.UpdateWithChangeSet([]*Validator{newValidator(addr(3), 10)}))
+
+		// a returning validator starts behind the others, never ahead
+		_, returning := vset.GetByAddress(addr(3))
+		for _, val := range vset.Validators {
+			if !bytes.Equal(val.Address, addr(3)) {
+				assert.Less(t, returning.ProposerPriority, val.ProposerPriority,
+					"re-added validator should have lower priority than %X", val.Address)
+			}
+		}
+		assert.LessOrEqual(t, maxConsecutive(vset, addr(3), numHeights), 1)
+	})
+}
+
 func newValidator(address []byte, power int64) *Validator {
 	return &Validator{Address: address, VotingPower: power}
 }
@@ -768,6 +863,57 @@ func TestValidatorSet_VerifyCommit_CheckAllSignatures(t *testing.T) {
 	}
 }
 
+// Check that VerifyCommit and VerifyCommitLight require strictly more than 2/3
+// of the total voting power, exactly at the boundary.
+func TestValidatorSet_VerifyCommit_TwoThirdsBoundary(t *testing.T) {
+	var (
+		chainID = "test_chain_id"
+		h       = int64(3)
+		blockID = makeBlockIDRandom()
+	)
+
+	testCases := []struct {
+		numVals  int
+		signed   int
+		expValid bool
+	}{
+		{3, 2, false},
+		{3, 3, true},
+		{6, 4, false}, // exactly 2/3 is not enough
+		{6, 5, true},
+		{7, 4, false},
+		{7, 5, true},
+		{10, 6, false},
+		{10, 7, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("%d of %d", tc.signed, tc.numVals), func(t *testing.T) {
+			voteSet, valSet, vals := randVoteSet(h, 0, tmproto.PrecommitType, tc.numVals, 1)
+			commit, err := MakeCommit(blockID, h, 0, voteSet, vals, time.Now())
+			require.NoError(t, err)
+			for i := tc.signed; i < tc.numVals; i++ {
+				commit.Signatures[i] = NewCommitSigAbsent()
+			}
+
+			for _, err := range []error{
+				valSet.VerifyCommit(chainID, blockID, h, commit),
+				valSet.VerifyCommitLight(chainID, blockID, h, commit),
+			} {
+				if tc.expValid {
+					assert.NoError(t, err)
+				} else {
+					assert.Equal(t, ErrNotEnoughVotingPowerSigned{
+						Got:    int64(tc.signed),
+						Needed: int64(tc.numVals * 2 / 3),
+					}, err)
+				}
+			}
+		})
+	}
+}
+
 func TestValidatorSet_VerifyCommitLight_ReturnsAsSoonAsMajorityOfVotingPowerSigned(t *testing.T) {
 	var (
 		chainID = "test_chain_id"